@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/posener/complete"
+
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/sdk/terminal"
+)
+
+type ArtifactDestroyCommand struct {
+	*baseCommand
+
+	flagForce       bool
+	flagAutoApprove bool
+	flagParallelism int
+}
+
+func (c *ArtifactDestroyCommand) Run(args []string) int {
+	ctx := c.Ctx
+	flags := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flags),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+	args = flags.Args()
+
+	workspace := c.project.WorkspaceRef().Workspace
+
+	// Determine the artifacts (pushed builds) to delete
+	var artifacts []*pb.PushedArtifact
+
+	var err error
+	if len(args) > 0 {
+		// If we have arguments, we only delete the artifacts specified.
+		artifacts, err = c.getArtifacts(ctx, args)
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+	} else {
+		// No arguments, get ALL artifacts.
+		artifacts, err = c.allArtifacts(ctx)
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+	}
+
+	// Build the plan and show it before destroying anything.
+	plan, toDestroy, skipped := clientpkg.NewArtifactDestroyPlan(artifacts, workspace)
+	if len(toDestroy) == 0 {
+		c.ui.Output("No artifacts to destroy.", terminal.WithHeaderStyle())
+		if len(skipped) > 0 {
+			c.ui.Table(clientpkg.Summarize(nil, skipped).Table())
+		}
+		return 0
+	}
+
+	// Confirm once against the plan, then execute.
+	confirmed, err := c.confirmDestroyPlan(plan, c.flagForce || c.flagAutoApprove)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	if !confirmed {
+		c.ui.Output("Destroy aborted.", terminal.WithHeaderStyle())
+		return 1
+	}
+
+	// Destroy each artifact, across applications in parallel.
+	var steps []clientpkg.DestroyStep
+	for _, artifact := range toDestroy {
+		artifact := artifact
+		app := c.project.App(artifact.Application.Application)
+		steps = append(steps, clientpkg.DestroyStep{
+			Id:          artifact.Id,
+			Application: artifact.Application.Application,
+			Kind:        clientpkg.StepKindArtifact,
+			Destroy: func(ctx context.Context) error {
+				c.ui.Output("Destroying artifact: %s", artifact.Id, terminal.WithInfoStyle())
+				return app.DestroyArtifact(ctx, &pb.Job_DestroyArtifactOp{Artifact: artifact})
+			},
+		})
+	}
+
+	executor := &clientpkg.DestroyExecutor{Parallelism: c.flagParallelism}
+	results := executor.Execute(ctx, steps)
+	summary := clientpkg.Summarize(results, skipped)
+
+	c.ui.Output("Destroy summary:", terminal.WithHeaderStyle())
+	c.ui.Table(summary.Table())
+
+	if summary.HasFailures() {
+		return 1
+	}
+
+	return 0
+}
+
+func (c *ArtifactDestroyCommand) getArtifacts(ctx context.Context, ids []string) ([]*pb.PushedArtifact, error) {
+	var result []*pb.PushedArtifact
+
+	// Get each artifact
+	client := c.project.Client()
+	for _, id := range ids {
+		artifact, err := client.GetPushedArtifact(ctx, &pb.GetPushedArtifactRequest{
+			Ref: &pb.Ref_Operation{
+				Target: &pb.Ref_Operation_Id{Id: id},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, artifact)
+	}
+
+	return result, nil
+}
+
+func (c *ArtifactDestroyCommand) allArtifacts(ctx context.Context) ([]*pb.PushedArtifact, error) {
+	var result []*pb.PushedArtifact
+
+	client := c.project.Client()
+	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		resp, err := client.ListPushedArtifacts(ctx, &pb.ListPushedArtifactsRequest{
+			Application: app.Ref(),
+			Workspace:   c.project.WorkspaceRef(),
+			Order: &pb.OperationOrder{
+				Order: pb.OperationOrder_COMPLETE_TIME,
+				Desc:  true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result = append(result, resp.Artifacts...)
+		return nil
+	})
+
+	return result, err
+}
+
+func (c *ArtifactDestroyCommand) Flags() *flag.Sets {
+	return c.flagSet(flagSetOperation, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:    "force",
+			Target:  &c.flagForce,
+			Usage:   "Destroy without confirmation.",
+			Default: false,
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "auto-approve",
+			Target:  &c.flagAutoApprove,
+			Usage:   "Alias of -force. Destroy without confirmation.",
+			Default: false,
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:    "parallelism",
+			Target:  &c.flagParallelism,
+			Usage:   "Number of applications to destroy concurrently.",
+			Default: clientpkg.DefaultDestroyParallelism,
+		})
+	})
+}
+
+func (c *ArtifactDestroyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ArtifactDestroyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ArtifactDestroyCommand) Synopsis() string {
+	return "Destroy one or more pushed artifacts."
+}
+
+func (c *ArtifactDestroyCommand) Help() string {
+	helpText := `
+Usage: waypoint artifact destroy [options] [id...]
+
+  Destroy one or more pushed artifacts (previously built and pushed
+  application archives).
+
+  When no arguments are given, this will default to destroying ALL
+  artifacts. This will require interactive confirmation by the user
+  unless the force flag (-force) is specified. If stderr is not a terminal,
+  -force is required since there is no way to prompt for confirmation.
+
+  Applications are destroyed in parallel (-parallelism). Every artifact is
+  attempted regardless of earlier failures; a summary of successes and
+  failures is printed at the end, and the command exits 1 if anything
+  failed.
+
+` + c.Flags().Help()
+
+	return strings.TrimSpace(helpText)
+}
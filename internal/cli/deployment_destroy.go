@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/posener/complete"
 
@@ -16,7 +17,13 @@ import (
 type DeploymentDestroyCommand struct {
 	*baseCommand
 
-	flagForce bool
+	flagForce       bool
+	flagAutoApprove bool
+	flagParallelism int
+	flagOlderThan   time.Duration
+	flagStatus      []string
+	flagLabel       []string
+	flagKeepLastN   int
 }
 
 func (c *DeploymentDestroyCommand) Run(args []string) int {
@@ -33,19 +40,24 @@ func (c *DeploymentDestroyCommand) Run(args []string) int {
 	}
 	args = flags.Args()
 
+	workspace := c.project.WorkspaceRef().Workspace
+
 	// Determine the deployments to delete
 	var deployments []*pb.Deployment
+	var skippedDeployments []*clientpkg.DestroyPlanItem
 
 	var err error
 	if len(args) > 0 {
 		// If we have arguments, we only delete the deployments specified.
-		deployments, err = c.getDeployments(ctx, args)
+		deployments, skippedDeployments, err = c.getDeployments(ctx, args)
 		if err != nil {
 			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
 			return 1
 		}
 	} else {
-		// No arguments, get ALL deployments that are still physically created.
+		// No arguments, get ALL deployments that are still physically
+		// created and match the selection flags (-older-than, -status,
+		// -label, -keep-last-n).
 		deployments, err = c.allDeployments(ctx)
 		if err != nil {
 			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
@@ -53,46 +65,167 @@ func (c *DeploymentDestroyCommand) Run(args []string) int {
 		}
 	}
 
-	// Destroy each deployment
-	c.ui.Output("%d deployments will be destroyed.", len(deployments), terminal.WithHeaderStyle())
-	for _, deployment := range deployments {
-		// Can't destroy a deployment that was not successful
-		if deployment.Status.GetState() != pb.Status_SUCCESS {
-			continue
+	// Build the plan and show it before destroying anything.
+	plan, toDestroy := clientpkg.NewDeploymentDestroyPlan(deployments, workspace)
+	if len(toDestroy) == 0 {
+		c.ui.Output("No deployments to destroy.", terminal.WithHeaderStyle())
+		if len(skippedDeployments) > 0 {
+			c.ui.Table(clientpkg.Summarize(nil, skippedDeployments).Table())
 		}
+		return 0
+	}
+
+	// A deployment can't be destroyed while a release still points at it,
+	// so fold the releases into the plan the user confirms against too.
+	releases, err := c.releasesForDeployments(ctx, toDestroy)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	releasePlan, toDestroyReleases, skippedReleases := clientpkg.NewReleaseDestroyPlan(releases, workspace)
+	plan.Items = append(releasePlan.Items, plan.Items...)
+
+	// Confirm once against the combined plan, then execute.
+	confirmed, err := c.confirmDestroyPlan(plan, c.flagForce || c.flagAutoApprove)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	if !confirmed {
+		c.ui.Output("Destroy aborted.", terminal.WithHeaderStyle())
+		return 1
+	}
 
-		// Get our app client
+	var steps []clientpkg.DestroyStep
+	for _, release := range toDestroyReleases {
+		release := release
+		app := c.project.App(release.Application.Application)
+		steps = append(steps, clientpkg.DestroyStep{
+			Id:          release.Id,
+			Application: release.Application.Application,
+			Kind:        clientpkg.StepKindRelease,
+			Destroy: func(ctx context.Context) error {
+				c.ui.Output("Destroying release: %s", release.Id, terminal.WithInfoStyle())
+				return app.DestroyRelease(ctx, &pb.Job_DestroyReleaseOp{Release: release})
+			},
+		})
+	}
+	for _, deployment := range toDestroy {
+		deployment := deployment
 		app := c.project.App(deployment.Application.Application)
+		steps = append(steps, clientpkg.DestroyStep{
+			Id:          deployment.Id,
+			Application: deployment.Application.Application,
+			Kind:        clientpkg.StepKindDeployment,
+			Destroy: func(ctx context.Context) error {
+				c.ui.Output("Destroying deployment: %s", deployment.Id, terminal.WithInfoStyle())
+				return app.DestroyDeploy(ctx, &pb.Job_DestroyDeployOp{Deployment: deployment})
+			},
+		})
+	}
 
-		c.ui.Output("Destroying deployment: %s", deployment.Id, terminal.WithInfoStyle())
-		if err := app.DestroyDeploy(ctx, &pb.Job_DestroyDeployOp{
-			Deployment: deployment,
-		}); err != nil {
-			c.ui.Output("Error destroying the deployment: %s", err.Error(), terminal.WithErrorStyle())
-			return 1
-		}
+	executor := &clientpkg.DestroyExecutor{Parallelism: c.flagParallelism}
+	results := executor.Execute(ctx, steps)
+	skipped := append(skippedReleases, skippedDeployments...)
+	summary := clientpkg.Summarize(results, skipped)
+
+	c.ui.Output("Destroy summary:", terminal.WithHeaderStyle())
+	c.ui.Table(summary.Table())
+
+	if summary.HasFailures() {
+		return 1
 	}
 
 	return 0
 }
 
-func (c *DeploymentDestroyCommand) getDeployments(ctx context.Context, ids []string) ([]*pb.Deployment, error) {
+// releasesForDeployments returns the physically-created releases that
+// actually point at one of the given deployments, so they can be destroyed
+// before the deployments they point to. It does NOT return every release
+// belonging to an affected application — a release pointing at a deployment
+// that isn't being destroyed is left alone, since destroying it would take
+// down live traffic the caller never asked to touch. Non-SUCCESS releases
+// are included here too; it's up to the caller (via NewReleaseDestroyPlan)
+// to separate what's actually destroyable from what should be reported as
+// skipped.
+func (c *DeploymentDestroyCommand) releasesForDeployments(ctx context.Context, deployments []*pb.Deployment) ([]*pb.Release, error) {
+	targetIds := map[string]struct{}{}
+	seen := map[string]struct{}{}
+	var apps []string
+	for _, d := range deployments {
+		targetIds[d.Id] = struct{}{}
+
+		name := d.Application.Application
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		apps = append(apps, name)
+	}
+
+	client := c.project.Client()
+	var result []*pb.Release
+	for _, appName := range apps {
+		resp, err := client.ListReleases(ctx, &pb.ListReleasesRequest{
+			Application:   c.project.App(appName).Ref(),
+			Workspace:     c.project.WorkspaceRef(),
+			PhysicalState: pb.Operation_CREATED,
+			Order: &pb.OperationOrder{
+				Order: pb.OperationOrder_COMPLETE_TIME,
+				Desc:  true,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, release := range resp.Releases {
+			if _, ok := targetIds[release.DeploymentId]; !ok {
+				continue
+			}
+
+			result = append(result, release)
+		}
+	}
+
+	return result, nil
+}
+
+// getDeployments looks up each explicitly-named deployment ID. -status
+// doesn't apply to explicit IDs, so this falls back to the prior default of
+// only destroying deployments that completed successfully; deployments in
+// any other state are returned as skipped items so they still show up in
+// the final summary instead of silently disappearing.
+func (c *DeploymentDestroyCommand) getDeployments(ctx context.Context, ids []string) ([]*pb.Deployment, []*clientpkg.DestroyPlanItem, error) {
 	var result []*pb.Deployment
+	var skipped []*clientpkg.DestroyPlanItem
 
-	// Get each deployment
 	client := c.project.Client()
 	for _, id := range ids {
 		deployment, err := client.GetDeployment(ctx, &pb.GetDeploymentRequest{
 			DeploymentId: id,
 		})
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		if deployment.Status.GetState() != pb.Status_SUCCESS {
+			skipped = append(skipped, &clientpkg.DestroyPlanItem{
+				Id:            deployment.Id,
+				Kind:          "deployment",
+				Application:   deployment.Application.Application,
+				Workspace:     c.project.WorkspaceRef().Workspace,
+				Sequence:      deployment.Sequence,
+				Age:           time.Since(deployment.Status.GetCompleteTime().AsTime()),
+				PhysicalState: deployment.State.String(),
+			})
+			continue
 		}
 
 		result = append(result, deployment)
 	}
 
-	return result, nil
+	return result, skipped, nil
 }
 
 func (c *DeploymentDestroyCommand) allDeployments(ctx context.Context) ([]*pb.Deployment, error) {
@@ -116,8 +249,16 @@ func (c *DeploymentDestroyCommand) allDeployments(ctx context.Context) ([]*pb.De
 		result = append(result, resp.Deployments...)
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return result, err
+	filter, err := newDeploymentFilter(c.flagOlderThan, c.flagStatus, c.flagLabel, c.flagKeepLastN)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter.apply(result), nil
 }
 
 func (c *DeploymentDestroyCommand) Flags() *flag.Sets {
@@ -126,9 +267,54 @@ func (c *DeploymentDestroyCommand) Flags() *flag.Sets {
 		f.BoolVar(&flag.BoolVar{
 			Name:    "force",
 			Target:  &c.flagForce,
-			Usage:   "Yes to all confirmations.",
+			Usage:   "Destroy without confirmation.",
 			Default: false,
 		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "auto-approve",
+			Target:  &c.flagAutoApprove,
+			Usage:   "Alias of -force. Destroy without confirmation.",
+			Default: false,
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:    "parallelism",
+			Target:  &c.flagParallelism,
+			Usage:   "Number of applications to destroy concurrently.",
+			Default: clientpkg.DefaultDestroyParallelism,
+		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:   "older-than",
+			Target: &c.flagOlderThan,
+			Usage: "Only destroy deployments that completed more than this long ago, " +
+				"e.g. -older-than=168h. Ignored when deployment IDs are given explicitly.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "status",
+			Target: &c.flagStatus,
+			Usage: "Only destroy deployments in the given status, e.g. -status=error. " +
+				"May be repeated or comma-separated. Defaults to success. Ignored when " +
+				"deployment IDs are given explicitly.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "label",
+			Target: &c.flagLabel,
+			Usage: "Only destroy deployments matching this label, in key=value form. " +
+				"May be repeated; all given labels must match. Ignored when deployment " +
+				"IDs are given explicitly.",
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:   "keep-last-n",
+			Target: &c.flagKeepLastN,
+			Usage: "Always retain the N most recent (highest sequence) successful deployments " +
+				"per application, even if they otherwise match. Ignored when deployment " +
+				"IDs are given explicitly.",
+		})
 	})
 }
 
@@ -153,7 +339,19 @@ Usage: waypoint deployment destroy [options] [id...]
 
   When no arguments are given, this will default to destroying ALL
   deployments. This will require interactive confirmation by the user
-  unless the force flag (-force) is specified.
+  unless the force flag (-force) is specified. If stderr is not a terminal,
+  -force is required since there is no way to prompt for confirmation.
+
+  Applications are destroyed in parallel (-parallelism). Within an
+  application, any release pointing at a deployment is destroyed before
+  the deployment itself. Every deployment is attempted regardless of
+  earlier failures; a summary of successes and failures is printed at
+  the end, and the command exits 1 if anything failed.
+
+  When no deployment IDs are given, the set of deployments can be
+  narrowed with -older-than, -status, -label, and -keep-last-n. These
+  compose with AND semantics, which makes it possible to script things
+  like pruning stale review-app deployments in CI.
 
 ` + c.Flags().Help()
 
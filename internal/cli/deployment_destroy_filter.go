@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// deploymentFilter narrows down the result of allDeployments before it's
+// shown in the destroy plan, so that bulk destroy can be targeted and
+// scripted (e.g. "clean up old dev deployments but keep the latest few")
+// instead of always hitting every SUCCESS deployment in the workspace.
+type deploymentFilter struct {
+	olderThan time.Duration
+	statuses  map[pb.Status_State]struct{}
+	labels    map[string]string
+	keepLastN int
+}
+
+// newDeploymentFilter builds a deploymentFilter from the command's flags.
+// With no -status given, it defaults to SUCCESS to preserve the prior
+// default behavior of bulk destroy.
+func newDeploymentFilter(olderThan time.Duration, statusFlags, labelFlags []string, keepLastN int) (*deploymentFilter, error) {
+	f := &deploymentFilter{
+		olderThan: olderThan,
+		keepLastN: keepLastN,
+	}
+
+	if len(statusFlags) == 0 {
+		statusFlags = []string{"success"}
+	}
+
+	f.statuses = map[pb.Status_State]struct{}{}
+	for _, raw := range statusFlags {
+		for _, name := range strings.Split(raw, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+
+			v, ok := pb.Status_State_value[strings.ToUpper(name)]
+			if !ok {
+				return nil, fmt.Errorf("unknown -status value %q", name)
+			}
+			f.statuses[pb.Status_State(v)] = struct{}{}
+		}
+	}
+
+	f.labels = map[string]string{}
+	for _, kv := range labelFlags {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("-label must be in the form key=value, got %q", kv)
+		}
+		f.labels[parts[0]] = parts[1]
+	}
+
+	return f, nil
+}
+
+// apply filters deployments by status, age, and labels (AND semantics), then
+// excludes the N most recent (highest sequence) SUCCESS deployments per
+// application regardless of the other filters. keepLastN is computed from
+// the full per-application deployment list passed in here, not from what's
+// left after the other filters run, so e.g. -older-than=168h -keep-last-n=3
+// protects the global 3 most recent deployments rather than the 3 most
+// recent among the already-old ones.
+func (f *deploymentFilter) apply(deployments []*pb.Deployment) []*pb.Deployment {
+	var retained map[string]struct{}
+	if f.keepLastN > 0 {
+		retained = retainedIdsLastNSuccessful(deployments, f.keepLastN)
+	}
+
+	var filtered []*pb.Deployment
+	for _, d := range deployments {
+		if _, ok := retained[d.Id]; ok {
+			continue
+		}
+
+		if _, ok := f.statuses[d.Status.GetState()]; !ok {
+			continue
+		}
+
+		if f.olderThan > 0 {
+			completeTime := d.Status.GetCompleteTime()
+			if completeTime == nil || time.Since(completeTime.AsTime()) < f.olderThan {
+				continue
+			}
+		}
+
+		if !labelsMatch(d.Labels, f.labels) {
+			continue
+		}
+
+		filtered = append(filtered, d)
+	}
+
+	return filtered
+}
+
+func labelsMatch(actual, want map[string]string) bool {
+	for k, v := range want {
+		if actual[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// retainedIdsLastNSuccessful returns the IDs of the N SUCCESS deployments
+// with the highest sequence per application, out of the full
+// deployment list given (which must not already be narrowed by status,
+// age, or label filters), so they can be excluded from destruction
+// regardless of what else matches.
+func retainedIdsLastNSuccessful(deployments []*pb.Deployment, n int) map[string]struct{} {
+	successByApp := map[string][]*pb.Deployment{}
+	for _, d := range deployments {
+		if d.Status.GetState() == pb.Status_SUCCESS {
+			successByApp[d.Application.Application] = append(successByApp[d.Application.Application], d)
+		}
+	}
+
+	retained := map[string]struct{}{}
+	for _, group := range successByApp {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Sequence > group[j].Sequence
+		})
+		for i := 0; i < len(group) && i < n; i++ {
+			retained[group[i].Id] = struct{}{}
+		}
+	}
+
+	return retained
+}
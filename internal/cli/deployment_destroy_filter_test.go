@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestNewDeploymentFilter_statusDefault(t *testing.T) {
+	require := require.New(t)
+
+	f, err := newDeploymentFilter(0, nil, nil, 0)
+	require.NoError(err)
+
+	deployments := []*pb.Deployment{
+		deploymentWithStatus("success", pb.Status_SUCCESS),
+		deploymentWithStatus("error", pb.Status_ERROR),
+	}
+
+	got := f.apply(deployments)
+	require.Len(got, 1)
+	require.Equal("success", got[0].Id)
+}
+
+func TestNewDeploymentFilter_statusExplicit(t *testing.T) {
+	require := require.New(t)
+
+	f, err := newDeploymentFilter(0, []string{"error"}, nil, 0)
+	require.NoError(err)
+
+	deployments := []*pb.Deployment{
+		deploymentWithStatus("success", pb.Status_SUCCESS),
+		deploymentWithStatus("error", pb.Status_ERROR),
+	}
+
+	got := f.apply(deployments)
+	require.Len(got, 1)
+	require.Equal("error", got[0].Id)
+}
+
+func TestNewDeploymentFilter_statusUnknown(t *testing.T) {
+	require := require.New(t)
+
+	_, err := newDeploymentFilter(0, []string{"bogus"}, nil, 0)
+	require.Error(err)
+}
+
+func TestNewDeploymentFilter_olderThan(t *testing.T) {
+	require := require.New(t)
+
+	f, err := newDeploymentFilter(time.Hour, nil, nil, 0)
+	require.NoError(err)
+
+	old := deploymentWithStatus("old", pb.Status_SUCCESS)
+	old.Status.CompleteTime = timestamppb.New(time.Now().Add(-2 * time.Hour))
+
+	recent := deploymentWithStatus("recent", pb.Status_SUCCESS)
+	recent.Status.CompleteTime = timestamppb.New(time.Now())
+
+	got := f.apply([]*pb.Deployment{old, recent})
+	require.Len(got, 1)
+	require.Equal("old", got[0].Id)
+}
+
+func TestNewDeploymentFilter_label(t *testing.T) {
+	require := require.New(t)
+
+	f, err := newDeploymentFilter(0, nil, []string{"env=staging"}, 0)
+	require.NoError(err)
+
+	staging := deploymentWithStatus("staging", pb.Status_SUCCESS)
+	staging.Labels = map[string]string{"env": "staging"}
+
+	prod := deploymentWithStatus("prod", pb.Status_SUCCESS)
+	prod.Labels = map[string]string{"env": "prod"}
+
+	got := f.apply([]*pb.Deployment{staging, prod})
+	require.Len(got, 1)
+	require.Equal("staging", got[0].Id)
+}
+
+func TestNewDeploymentFilter_labelInvalid(t *testing.T) {
+	require := require.New(t)
+
+	_, err := newDeploymentFilter(0, nil, []string{"not-a-kv-pair"}, 0)
+	require.Error(err)
+}
+
+func TestNewDeploymentFilter_keepLastN(t *testing.T) {
+	require := require.New(t)
+
+	f, err := newDeploymentFilter(0, nil, nil, 1)
+	require.NoError(err)
+
+	newer := deploymentWithStatus("newer", pb.Status_SUCCESS)
+	newer.Sequence = 2
+	older := deploymentWithStatus("older", pb.Status_SUCCESS)
+	older.Sequence = 1
+
+	got := f.apply([]*pb.Deployment{newer, older})
+	require.Len(got, 1)
+	require.Equal("older", got[0].Id)
+}
+
+func TestNewDeploymentFilter_olderThanAndKeepLastN(t *testing.T) {
+	require := require.New(t)
+
+	// 2 recent SUCCESS deployments (excluded by -older-than on their own)
+	// and 3 old SUCCESS deployments. -keep-last-n=3 must protect the 3
+	// globally most recent deployments by sequence (the 2 recent ones plus
+	// old-3, the highest sequence of the old ones), not the 3 most recent
+	// *among the old ones*.
+	f, err := newDeploymentFilter(168*time.Hour, nil, nil, 3)
+	require.NoError(err)
+
+	deployments := []*pb.Deployment{
+		agedDeployment("recent-1", 4, time.Hour),
+		agedDeployment("recent-2", 5, time.Hour),
+		agedDeployment("old-1", 1, 200*time.Hour),
+		agedDeployment("old-2", 2, 300*time.Hour),
+		agedDeployment("old-3", 3, 400*time.Hour),
+	}
+
+	got := f.apply(deployments)
+	require.Len(got, 2)
+
+	var gotIds []string
+	for _, d := range got {
+		gotIds = append(gotIds, d.Id)
+	}
+	require.ElementsMatch([]string{"old-1", "old-2"}, gotIds)
+}
+
+func agedDeployment(id string, sequence uint64, age time.Duration) *pb.Deployment {
+	d := deploymentWithStatus(id, pb.Status_SUCCESS)
+	d.Sequence = sequence
+	d.Status.CompleteTime = timestamppb.New(time.Now().Add(-age))
+	return d
+}
+
+func deploymentWithStatus(id string, state pb.Status_State) *pb.Deployment {
+	return &pb.Deployment{
+		Id:          id,
+		Application: &pb.Ref_Application{Application: "test-app"},
+		Status: &pb.Status{
+			State:        state,
+			CompleteTime: timestamppb.New(time.Now()),
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	// mattn/go-isatty is already pulled in transitively by this module's
+	// existing terminal/color dependencies (sdk/terminal and its own
+	// deps); this is the first direct import of it. There's no go.mod in
+	// this checkout to promote it from indirect to direct in (there
+	// never has been one here, see the baseline commit), so there's no
+	// go.mod/go.sum diff to land alongside this file; `go mod tidy`
+	// against the real module tree will pick up the promotion the next
+	// time it runs there.
+	"github.com/mattn/go-isatty"
+
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/sdk/terminal"
+)
+
+// confirmDestroyPlan renders the given plan and prompts the user to confirm
+// before proceeding. If force is true, this is a no-op and returns true
+// immediately without rendering anything.
+//
+// If stderr is not a TTY, this refuses to proceed and returns an error
+// rather than silently assuming consent, since there is no way to prompt
+// the user.
+func (c *baseCommand) confirmDestroyPlan(plan *clientpkg.DestroyPlan, force bool) (bool, error) {
+	if force {
+		return true, nil
+	}
+
+	if !isatty.IsTerminal(os.Stderr.Fd()) {
+		return false, fmt.Errorf(
+			"refusing to destroy without confirmation: stderr is not a terminal.\n" +
+				"Pass -force to skip the interactive confirmation.")
+	}
+
+	c.ui.Output("The following will be destroyed:", terminal.WithHeaderStyle())
+	c.ui.Table(plan.Table())
+
+	confirmed, err := c.ui.Input(&terminal.Input{
+		Prompt: "Proceed with destruction? [y/N]: ",
+		Style:  terminal.WarningStyle,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	switch confirmed {
+	case "y", "Y", "yes", "Yes", "YES":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/posener/complete"
+
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/sdk/terminal"
+)
+
+type ReleaseDestroyCommand struct {
+	*baseCommand
+
+	flagForce       bool
+	flagAutoApprove bool
+	flagParallelism int
+}
+
+func (c *ReleaseDestroyCommand) Run(args []string) int {
+	ctx := c.Ctx
+	flags := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flags),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+	args = flags.Args()
+
+	workspace := c.project.WorkspaceRef().Workspace
+
+	// Determine the releases to delete
+	var releases []*pb.Release
+
+	var err error
+	if len(args) > 0 {
+		// If we have arguments, we only delete the releases specified.
+		releases, err = c.getReleases(ctx, args)
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+	} else {
+		// No arguments, get ALL releases that are still physically created.
+		releases, err = c.allReleases(ctx)
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+	}
+
+	// Build the plan and show it before destroying anything.
+	plan, toDestroy, skipped := clientpkg.NewReleaseDestroyPlan(releases, workspace)
+	if len(toDestroy) == 0 {
+		c.ui.Output("No releases to destroy.", terminal.WithHeaderStyle())
+		if len(skipped) > 0 {
+			c.ui.Table(clientpkg.Summarize(nil, skipped).Table())
+		}
+		return 0
+	}
+
+	// Confirm once against the plan, then execute.
+	confirmed, err := c.confirmDestroyPlan(plan, c.flagForce || c.flagAutoApprove)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	if !confirmed {
+		c.ui.Output("Destroy aborted.", terminal.WithHeaderStyle())
+		return 1
+	}
+
+	// Destroy each release, across applications in parallel.
+	var steps []clientpkg.DestroyStep
+	for _, release := range toDestroy {
+		release := release
+		app := c.project.App(release.Application.Application)
+		steps = append(steps, clientpkg.DestroyStep{
+			Id:          release.Id,
+			Application: release.Application.Application,
+			Kind:        clientpkg.StepKindRelease,
+			Destroy: func(ctx context.Context) error {
+				c.ui.Output("Destroying release: %s", release.Id, terminal.WithInfoStyle())
+				return app.DestroyRelease(ctx, &pb.Job_DestroyReleaseOp{Release: release})
+			},
+		})
+	}
+
+	executor := &clientpkg.DestroyExecutor{Parallelism: c.flagParallelism}
+	results := executor.Execute(ctx, steps)
+	summary := clientpkg.Summarize(results, skipped)
+
+	c.ui.Output("Destroy summary:", terminal.WithHeaderStyle())
+	c.ui.Table(summary.Table())
+
+	if summary.HasFailures() {
+		return 1
+	}
+
+	return 0
+}
+
+func (c *ReleaseDestroyCommand) getReleases(ctx context.Context, ids []string) ([]*pb.Release, error) {
+	var result []*pb.Release
+
+	// Get each release
+	client := c.project.Client()
+	for _, id := range ids {
+		release, err := client.GetRelease(ctx, &pb.GetReleaseRequest{
+			ReleaseId: id,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, release)
+	}
+
+	return result, nil
+}
+
+func (c *ReleaseDestroyCommand) allReleases(ctx context.Context) ([]*pb.Release, error) {
+	var result []*pb.Release
+
+	client := c.project.Client()
+	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		resp, err := client.ListReleases(ctx, &pb.ListReleasesRequest{
+			Application:   app.Ref(),
+			Workspace:     c.project.WorkspaceRef(),
+			PhysicalState: pb.Operation_CREATED,
+			Order: &pb.OperationOrder{
+				Order: pb.OperationOrder_COMPLETE_TIME,
+				Desc:  true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result = append(result, resp.Releases...)
+		return nil
+	})
+
+	return result, err
+}
+
+func (c *ReleaseDestroyCommand) Flags() *flag.Sets {
+	return c.flagSet(flagSetOperation, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:    "force",
+			Target:  &c.flagForce,
+			Usage:   "Destroy without confirmation.",
+			Default: false,
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "auto-approve",
+			Target:  &c.flagAutoApprove,
+			Usage:   "Alias of -force. Destroy without confirmation.",
+			Default: false,
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:    "parallelism",
+			Target:  &c.flagParallelism,
+			Usage:   "Number of applications to destroy concurrently.",
+			Default: clientpkg.DefaultDestroyParallelism,
+		})
+	})
+}
+
+func (c *ReleaseDestroyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ReleaseDestroyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ReleaseDestroyCommand) Synopsis() string {
+	return "Destroy one or more releases."
+}
+
+func (c *ReleaseDestroyCommand) Help() string {
+	helpText := `
+Usage: waypoint release destroy [options] [id...]
+
+  Destroy one or more releases. This will "unrelease" this specific
+  instance of an application.
+
+  When no arguments are given, this will default to destroying ALL
+  releases. This will require interactive confirmation by the user
+  unless the force flag (-force) is specified. If stderr is not a terminal,
+  -force is required since there is no way to prompt for confirmation.
+
+  Applications are destroyed in parallel (-parallelism). Every release is
+  attempted regardless of earlier failures; a summary of successes and
+  failures is printed at the end, and the command exits 1 if anything
+  failed.
+
+` + c.Flags().Help()
+
+	return strings.TrimSpace(helpText)
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/sdk/terminal"
+)
+
+// DestroyPlanItem describes a single resource targeted by a destroy plan.
+type DestroyPlanItem struct {
+	Id            string
+	Kind          string
+	Application   string
+	Workspace     string
+	Sequence      uint64
+	Age           time.Duration
+	PhysicalState string
+}
+
+// DestroyPlan is the set of resources that a destroy operation intends to
+// remove. It is built in a "plan" phase so the CLI can render a single,
+// accurate summary of impact before anything is destroyed, instead of
+// streaming per-resource output with no chance for the user to back out.
+type DestroyPlan struct {
+	Items []*DestroyPlanItem
+}
+
+// Table renders the plan as a terminal table suitable for display prior to
+// confirmation.
+func (p *DestroyPlan) Table() *terminal.Table {
+	tbl := terminal.NewTable("ID", "Kind", "Application", "Workspace", "Seq", "Age", "State")
+	for _, item := range p.Items {
+		tbl.Rich([]string{
+			item.Id,
+			item.Kind,
+			item.Application,
+			item.Workspace,
+			fmt.Sprintf("%d", item.Sequence),
+			item.Age.Round(time.Second).String(),
+			item.PhysicalState,
+		}, nil)
+	}
+
+	return tbl
+}
+
+// NewDeploymentDestroyPlan builds a DestroyPlan from deployments the caller
+// has already selected for destruction. Unlike NewArtifactDestroyPlan and
+// NewReleaseDestroyPlan, it doesn't filter by status itself, since a caller
+// may legitimately target non-SUCCESS deployments (e.g. -status=error).
+func NewDeploymentDestroyPlan(deployments []*pb.Deployment, workspace string) (*DestroyPlan, []*pb.Deployment) {
+	plan := &DestroyPlan{}
+
+	for _, d := range deployments {
+		plan.Items = append(plan.Items, &DestroyPlanItem{
+			Id:            d.Id,
+			Kind:          "deployment",
+			Application:   d.Application.Application,
+			Workspace:     workspace,
+			Sequence:      d.Sequence,
+			Age:           time.Since(d.Status.GetCompleteTime().AsTime()),
+			PhysicalState: d.State.String(),
+		})
+	}
+
+	return plan, deployments
+}
+
+// NewArtifactDestroyPlan builds a DestroyPlan from a list of pushed
+// artifacts, filtering out non-SUCCESS ones as skipped.
+func NewArtifactDestroyPlan(artifacts []*pb.PushedArtifact, workspace string) (plan *DestroyPlan, destroyable []*pb.PushedArtifact, skipped []*DestroyPlanItem) {
+	plan = &DestroyPlan{}
+
+	for _, a := range artifacts {
+		item := &DestroyPlanItem{
+			Id:            a.Id,
+			Kind:          "artifact",
+			Application:   a.Application.Application,
+			Workspace:     workspace,
+			Sequence:      a.Sequence,
+			Age:           time.Since(a.Status.GetCompleteTime().AsTime()),
+			PhysicalState: "N/A",
+		}
+
+		if a.Status.GetState() != pb.Status_SUCCESS {
+			skipped = append(skipped, item)
+			continue
+		}
+
+		destroyable = append(destroyable, a)
+		plan.Items = append(plan.Items, item)
+	}
+
+	return plan, destroyable, skipped
+}
+
+// NewReleaseDestroyPlan builds a DestroyPlan from a list of releases,
+// filtering out non-SUCCESS ones as skipped.
+func NewReleaseDestroyPlan(releases []*pb.Release, workspace string) (plan *DestroyPlan, destroyable []*pb.Release, skipped []*DestroyPlanItem) {
+	plan = &DestroyPlan{}
+
+	for _, r := range releases {
+		item := &DestroyPlanItem{
+			Id:            r.Id,
+			Kind:          "release",
+			Application:   r.Application.Application,
+			Workspace:     workspace,
+			Sequence:      r.Sequence,
+			Age:           time.Since(r.Status.GetCompleteTime().AsTime()),
+			PhysicalState: r.State.String(),
+		}
+
+		if r.Status.GetState() != pb.Status_SUCCESS {
+			skipped = append(skipped, item)
+			continue
+		}
+
+		destroyable = append(destroyable, r)
+		plan.Items = append(plan.Items, item)
+	}
+
+	return plan, destroyable, skipped
+}
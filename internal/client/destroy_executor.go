@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/waypoint/sdk/terminal"
+)
+
+// DefaultDestroyParallelism is the number of applications that a
+// DestroyExecutor will destroy concurrently when no explicit parallelism is
+// configured.
+const DefaultDestroyParallelism = 10
+
+// DestroyStep is a single unit of work for a DestroyExecutor: destroying one
+// resource (a release, deployment, artifact, etc.) belonging to one
+// application.
+type DestroyStep struct {
+	Id          string
+	Application string
+
+	// Kind determines ordering relative to other steps in the same
+	// application. Lower-numbered kinds are destroyed first.
+	Kind DestroyStepKind
+
+	// Destroy performs the actual destroy operation for this step.
+	Destroy func(ctx context.Context) error
+}
+
+// DestroyStepKind orders steps within an application. A release must be
+// destroyed before the deployment it points to, so StepKindRelease runs
+// before StepKindDeployment.
+type DestroyStepKind int
+
+const (
+	StepKindRelease DestroyStepKind = iota
+	StepKindDeployment
+	StepKindArtifact
+)
+
+// DestroyResult is the outcome of executing a single DestroyStep.
+type DestroyResult struct {
+	Step DestroyStep
+	Err  error
+}
+
+// DestroyExecutor runs a set of DestroySteps grouped by application,
+// destroying independent applications in parallel while respecting
+// dependency ordering (releases before deployments) within an application.
+// Every step is attempted regardless of earlier failures; errors are
+// collected rather than aborting the run.
+type DestroyExecutor struct {
+	// Parallelism is the number of applications to destroy concurrently.
+	// Defaults to DefaultDestroyParallelism if <= 0.
+	Parallelism int
+}
+
+// Execute runs every step, returning one DestroyResult per step in
+// execution order. It always attempts every step, even after failures.
+func (e *DestroyExecutor) Execute(ctx context.Context, steps []DestroyStep) []DestroyResult {
+	parallelism := e.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultDestroyParallelism
+	}
+
+	var appOrder []string
+	byApp := map[string][]DestroyStep{}
+	for _, step := range steps {
+		if _, ok := byApp[step.Application]; !ok {
+			appOrder = append(appOrder, step.Application)
+		}
+		byApp[step.Application] = append(byApp[step.Application], step)
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, parallelism)
+		results = make([]DestroyResult, 0, len(steps))
+	)
+
+	for _, app := range appOrder {
+		appSteps := orderStepsByKind(byApp[app])
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(appSteps []DestroyStep) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			for _, step := range appSteps {
+				err := step.Destroy(ctx)
+
+				mu.Lock()
+				results = append(results, DestroyResult{Step: step, Err: err})
+				mu.Unlock()
+			}
+		}(appSteps)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// orderStepsByKind sorts steps within a single application so that
+// dependent resources are destroyed before what they depend on (releases
+// before deployments, for example), without reordering steps of the same
+// kind relative to one another.
+func orderStepsByKind(steps []DestroyStep) []DestroyStep {
+	ordered := make([]DestroyStep, len(steps))
+	copy(ordered, steps)
+
+	for i := 1; i < len(ordered); i++ {
+		for j := i; j > 0 && ordered[j].Kind < ordered[j-1].Kind; j-- {
+			ordered[j], ordered[j-1] = ordered[j-1], ordered[j]
+		}
+	}
+
+	return ordered
+}
+
+// DestroySummary buckets a set of DestroyResults into succeeded and failed,
+// plus any items that were skipped (filtered out as non-destroyable) before
+// ever reaching the executor, for reporting.
+type DestroySummary struct {
+	Succeeded []DestroyResult
+	Failed    []DestroyResult
+	Skipped   []*DestroyPlanItem
+}
+
+// Summarize buckets results into a DestroySummary, carrying along any items
+// that were skipped before execution so they still show up in the final
+// report instead of disappearing with no trace.
+func Summarize(results []DestroyResult, skipped []*DestroyPlanItem) *DestroySummary {
+	summary := &DestroySummary{Skipped: skipped}
+	for _, result := range results {
+		if result.Err != nil {
+			summary.Failed = append(summary.Failed, result)
+		} else {
+			summary.Succeeded = append(summary.Succeeded, result)
+		}
+	}
+
+	return summary
+}
+
+// HasFailures reports whether any step failed.
+func (s *DestroySummary) HasFailures() bool {
+	return len(s.Failed) > 0
+}
+
+// Table renders the summary as a terminal table of every attempted step and
+// its outcome, plus a row for every item that was skipped before execution.
+func (s *DestroySummary) Table() *terminal.Table {
+	tbl := terminal.NewTable("ID", "Application", "Status", "Error")
+	for _, result := range s.Succeeded {
+		tbl.Rich([]string{result.Step.Id, result.Step.Application, "success", ""}, nil)
+	}
+	for _, result := range s.Failed {
+		tbl.Rich([]string{result.Step.Id, result.Step.Application, "failed", fmt.Sprintf("%s", result.Err)}, nil)
+	}
+	for _, item := range s.Skipped {
+		tbl.Rich([]string{item.Id, item.Application, "skipped", ""}, nil)
+	}
+
+	return tbl
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDestroyExecutor_Execute_ordersByKindWithinApp(t *testing.T) {
+	require := require.New(t)
+
+	var mu sync.Mutex
+	var order []string
+
+	step := func(id, app string, kind DestroyStepKind) DestroyStep {
+		return DestroyStep{
+			Id:          id,
+			Application: app,
+			Kind:        kind,
+			Destroy: func(ctx context.Context) error {
+				mu.Lock()
+				order = append(order, id)
+				mu.Unlock()
+				return nil
+			},
+		}
+	}
+
+	executor := &DestroyExecutor{Parallelism: 1}
+	results := executor.Execute(context.Background(), []DestroyStep{
+		step("deployment-1", "app", StepKindDeployment),
+		step("release-1", "app", StepKindRelease),
+		step("artifact-1", "app", StepKindArtifact),
+	})
+
+	require.Len(results, 3)
+	require.Equal([]string{"release-1", "deployment-1", "artifact-1"}, order)
+}
+
+func TestDestroyExecutor_Execute_attemptsEveryStepAfterFailure(t *testing.T) {
+	require := require.New(t)
+
+	failErr := errors.New("boom")
+	steps := []DestroyStep{
+		{
+			Id:          "release-1",
+			Application: "app",
+			Kind:        StepKindRelease,
+			Destroy:     func(ctx context.Context) error { return failErr },
+		},
+		{
+			Id:          "deployment-1",
+			Application: "app",
+			Kind:        StepKindDeployment,
+			Destroy:     func(ctx context.Context) error { return nil },
+		},
+	}
+
+	executor := &DestroyExecutor{Parallelism: 1}
+	results := executor.Execute(context.Background(), steps)
+
+	require.Len(results, 2)
+	summary := Summarize(results, nil)
+	require.Len(summary.Failed, 1)
+	require.Len(summary.Succeeded, 1)
+	require.True(summary.HasFailures())
+}
+
+func TestDestroyExecutor_Execute_runsEveryApplication(t *testing.T) {
+	require := require.New(t)
+
+	const apps = 5
+	var mu sync.Mutex
+	seen := map[string]struct{}{}
+
+	var steps []DestroyStep
+	for i := 0; i < apps; i++ {
+		app := string(rune('a' + i))
+		steps = append(steps, DestroyStep{
+			Id:          "deployment-1",
+			Application: app,
+			Kind:        StepKindDeployment,
+			Destroy: func(ctx context.Context) error {
+				mu.Lock()
+				seen[app] = struct{}{}
+				mu.Unlock()
+				return nil
+			},
+		})
+	}
+
+	executor := &DestroyExecutor{Parallelism: apps}
+	results := executor.Execute(context.Background(), steps)
+	require.Len(results, apps)
+	require.Len(seen, apps)
+}
+
+func TestSummarize_includesSkipped(t *testing.T) {
+	require := require.New(t)
+
+	skipped := []*DestroyPlanItem{
+		{Id: "release-2", Application: "app"},
+	}
+
+	summary := Summarize(nil, skipped)
+	require.Len(summary.Skipped, 1)
+	require.False(summary.HasFailures())
+
+	tbl := summary.Table()
+	require.NotNil(tbl)
+}